@@ -0,0 +1,56 @@
+// Package aqi converts particulate concentrations reported by PurpleAir
+// sensors into the US EPA Air Quality Index.
+package aqi
+
+import (
+	"errors"
+	"math"
+
+	"github.com/steventblack/purpleair/internal/floats"
+)
+
+// ErrInvalidConcentration is returned when a concentration is negative or not finite.
+var ErrInvalidConcentration = errors.New("aqi: invalid concentration")
+
+// breakpoint maps a concentration range onto an index range.
+type breakpoint struct {
+	cLow, cHigh float64
+	iLow, iHigh int
+}
+
+// pm25Breakpoints is the EPA 24-hour PM2.5 breakpoint table (µg/m³), as revised in 2024.
+var pm25Breakpoints = []breakpoint{
+	{0.0, 9.0, 0, 50},
+	{9.1, 35.4, 51, 100},
+	{35.5, 55.4, 101, 150},
+	{55.5, 125.4, 151, 200},
+	{125.5, 225.4, 201, 300},
+	{225.5, 325.4, 301, 500},
+}
+
+// AQIFromPM25 returns the US EPA AQI for a PM2.5 concentration in µg/m³.
+// The concentration is truncated to one decimal place as the EPA specifies.
+// Concentrations above the top of the table, which the EPA treats as
+// beyond the AQI, are reported as the maximum index of 500.
+func AQIFromPM25(pm float64) (int, error) {
+	if !floats.NonNegative(pm) {
+		return 0, ErrInvalidConcentration
+	}
+
+	// The small epsilon keeps values such as 2.3 from truncating to 2.2
+	// when their binary representation falls just below the decimal.
+	c := math.Floor(pm*10+1e-9) / 10
+	for _, bp := range pm25Breakpoints {
+		if c <= bp.cHigh {
+			return bp.index(c), nil
+		}
+	}
+
+	return pm25Breakpoints[len(pm25Breakpoints)-1].iHigh, nil
+}
+
+// index linearly interpolates the concentration c within the breakpoint.
+func (bp breakpoint) index(c float64) int {
+	i := float64(bp.iHigh-bp.iLow)/(bp.cHigh-bp.cLow)*(c-bp.cLow) + float64(bp.iLow)
+	return int(math.Round(i))
+}
@@ -0,0 +1,53 @@
+package aqi
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestAQIFromPM25(t *testing.T) {
+	tests := []struct {
+		pm   float64
+		want int
+	}{
+		{0.0, 0},
+		{9.0, 50},
+		{9.1, 51},
+		{35.4, 100},
+		{35.5, 101},
+		{55.4, 150},
+		{55.5, 151},
+		{125.4, 200},
+		{125.5, 201},
+		{225.4, 300},
+		{225.5, 301},
+		{325.4, 500},
+		{500.0, 500},
+		{math.MaxFloat64, 500},
+
+		// Truncation to one decimal place.
+		{9.05, 50},
+		{0.29, 1}, // truncated to 0.2; 0.29 would round to 2
+		{2.3, 13}, // must not truncate to 2.2
+	}
+
+	for _, tc := range tests {
+		got, err := AQIFromPM25(tc.pm)
+		if err != nil {
+			t.Errorf("AQIFromPM25(%v) returned error: %v", tc.pm, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("AQIFromPM25(%v) = %d, want %d", tc.pm, got, tc.want)
+		}
+	}
+}
+
+func TestAQIFromPM25Invalid(t *testing.T) {
+	for _, pm := range []float64{math.NaN(), -0.1, math.Inf(1), math.Inf(-1)} {
+		if _, err := AQIFromPM25(pm); !errors.Is(err, ErrInvalidConcentration) {
+			t.Errorf("AQIFromPM25(%v) error = %v, want %v", pm, err, ErrInvalidConcentration)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package aqi
+
+import "math"
+
+// Category is an EPA AQI category.
+type Category int
+
+// The EPA AQI categories, from best to worst.
+const (
+	Good Category = iota
+	Moderate
+	USG
+	Unhealthy
+	VeryUnhealthy
+	Hazardous
+)
+
+// categoryInfo describes a category's range, standard color and health message.
+type categoryInfo struct {
+	name    string
+	color   string
+	message string
+	low     int
+	high    int
+}
+
+// categories is indexed by Category, in ascending order.
+var categories = [...]categoryInfo{
+	Good: {
+		name:    "Good",
+		color:   "#00E400",
+		message: "Air quality is satisfactory, and air pollution poses little or no risk.",
+		low:     0,
+		high:    50,
+	},
+	Moderate: {
+		name:    "Moderate",
+		color:   "#FFFF00",
+		message: "Air quality is acceptable. However, there may be a risk for some people, particularly those who are unusually sensitive to air pollution.",
+		low:     51,
+		high:    100,
+	},
+	USG: {
+		name:    "Unhealthy for Sensitive Groups",
+		color:   "#FF7E00",
+		message: "Members of sensitive groups may experience health effects. The general public is less likely to be affected.",
+		low:     101,
+		high:    150,
+	},
+	Unhealthy: {
+		name:    "Unhealthy",
+		color:   "#FF0000",
+		message: "Some members of the general public may experience health effects; members of sensitive groups may experience more serious health effects.",
+		low:     151,
+		high:    200,
+	},
+	VeryUnhealthy: {
+		name:    "Very Unhealthy",
+		color:   "#8F3F97",
+		message: "Health alert: The risk of health effects is increased for everyone.",
+		low:     201,
+		high:    300,
+	},
+	Hazardous: {
+		name:    "Hazardous",
+		color:   "#7E0023",
+		message: "Health warning of emergency conditions: everyone is more likely to be affected.",
+		low:     301,
+		high:    math.MaxInt,
+	},
+}
+
+// info returns the description of c, treating unknown values as Hazardous.
+func (c Category) info() categoryInfo {
+	if c < Good || c > Hazardous {
+		return categories[Hazardous]
+	}
+
+	return categories[c]
+}
+
+// String returns the category name, e.g. "Moderate".
+func (c Category) String() string { return c.info().name }
+
+// Color returns the standard EPA color as a hex string, e.g. "#FFFF00".
+func (c Category) Color() string { return c.info().color }
+
+// Message returns the cautionary health statement for the category.
+func (c Category) Message() string { return c.info().message }
+
+// Low returns the lowest AQI in the category.
+func (c Category) Low() int { return c.info().low }
+
+// High returns the highest AQI in the category; math.MaxInt if unbounded.
+func (c Category) High() int { return c.info().high }
+
+// Contains reports whether aqi falls within the category's range.
+func (c Category) Contains(aqi int) bool {
+	return aqi >= c.Low() && aqi <= c.High()
+}
+
+// CategoryOf returns the category for an AQI value.
+// Negative values are reported as Good.
+func CategoryOf(aqi int) Category {
+	for c := Good; c < Hazardous; c++ {
+		if aqi <= c.High() {
+			return c
+		}
+	}
+
+	return Hazardous
+}
+
+// Categories returns the AQI categories in ascending order.
+func Categories() []Category {
+	return []Category{Good, Moderate, USG, Unhealthy, VeryUnhealthy, Hazardous}
+}
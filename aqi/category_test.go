@@ -0,0 +1,74 @@
+package aqi
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCategoryOf(t *testing.T) {
+	tests := []struct {
+		aqi  int
+		want Category
+	}{
+		{-1, Good},
+		{0, Good},
+		{50, Good},
+		{51, Moderate},
+		{100, Moderate},
+		{101, USG},
+		{150, USG},
+		{151, Unhealthy},
+		{200, Unhealthy},
+		{201, VeryUnhealthy},
+		{300, VeryUnhealthy},
+		{301, Hazardous},
+		{500, Hazardous},
+		{999, Hazardous},
+	}
+
+	for _, tc := range tests {
+		got := CategoryOf(tc.aqi)
+		if got != tc.want {
+			t.Errorf("CategoryOf(%d) = %v, want %v", tc.aqi, got, tc.want)
+		}
+		if tc.aqi >= 0 && !got.Contains(tc.aqi) {
+			t.Errorf("%v.Contains(%d) = false, want true", got, tc.aqi)
+		}
+	}
+}
+
+func TestCategoryInfo(t *testing.T) {
+	tests := []struct {
+		c         Category
+		name      string
+		color     string
+		low, high int
+	}{
+		{Good, "Good", "#00E400", 0, 50},
+		{USG, "Unhealthy for Sensitive Groups", "#FF7E00", 101, 150},
+		{Hazardous, "Hazardous", "#7E0023", 301, math.MaxInt},
+	}
+
+	for _, tc := range tests {
+		if tc.c.String() != tc.name || tc.c.Color() != tc.color || tc.c.Low() != tc.low || tc.c.High() != tc.high {
+			t.Errorf("%v = {%q %q %d %d}, want {%q %q %d %d}", tc.c,
+				tc.c.String(), tc.c.Color(), tc.c.Low(), tc.c.High(),
+				tc.name, tc.color, tc.low, tc.high)
+		}
+		if tc.c.Message() == "" {
+			t.Errorf("%v has no health message", tc.c)
+		}
+	}
+}
+
+func TestCategoriesCopy(t *testing.T) {
+	c := Categories()
+	c[0] = Hazardous
+
+	if Categories()[0] != Good {
+		t.Errorf("Categories() exposed package state to mutation")
+	}
+	if CategoryOf(0) != Good {
+		t.Errorf("CategoryOf(0) affected by mutation of Categories() result")
+	}
+}
@@ -0,0 +1,44 @@
+package aqi
+
+import "github.com/steventblack/purpleair/internal/floats"
+
+// Correction adjusts a raw PM2.5 concentration (µg/m³) reported by a
+// PurpleAir sensor before it is converted to an AQI.
+type Correction func(pm float64) float64
+
+// Corrections offered as conversion options on the PurpleAir map.
+var (
+	// NoCorrection returns the concentration unchanged.
+	NoCorrection Correction = func(pm float64) float64 { return pm }
+
+	// LRAPA applies the Lane Regional Air Protection Agency correction to
+	// the pm2.5_cf_atm value: 0.5 × PM2.5 − 0.66.
+	LRAPA Correction = func(pm float64) float64 { return clamp(0.5*pm - 0.66) }
+
+	// AQandU applies the University of Utah AQ&U correction to the
+	// pm2.5_cf_atm value: 0.778 × PM2.5 + 2.65.
+	AQandU Correction = func(pm float64) float64 { return clamp(0.778*pm + 2.65) }
+)
+
+// AQIFromPM25With applies the correction c to pm and returns the resulting AQI.
+// Both the raw and the corrected concentration must be valid.
+// A nil correction is treated as NoCorrection.
+func AQIFromPM25With(pm float64, c Correction) (int, error) {
+	if !floats.NonNegative(pm) {
+		return 0, ErrInvalidConcentration
+	}
+	if c == nil {
+		c = NoCorrection
+	}
+
+	return AQIFromPM25(c(pm))
+}
+
+// clamp keeps corrected concentrations from going negative at low readings.
+func clamp(pm float64) float64 {
+	if pm < 0 {
+		return 0
+	}
+
+	return pm
+}
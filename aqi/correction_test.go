@@ -0,0 +1,72 @@
+package aqi
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCorrections(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Correction
+		pm   float64
+		want float64
+	}{
+		{"NoCorrection", NoCorrection, 10, 10},
+		{"LRAPA", LRAPA, 10, 4.34},
+		{"LRAPA clamped", LRAPA, 1.0, 0},
+		{"AQandU", AQandU, 10, 10.43},
+	}
+
+	for _, tc := range tests {
+		if got := tc.c(tc.pm); math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("%s(%v) = %v, want %v", tc.name, tc.pm, got, tc.want)
+		}
+	}
+}
+
+func TestAQIFromPM25With(t *testing.T) {
+	tests := []struct {
+		name string
+		pm   float64
+		c    Correction
+		want int
+	}{
+		{"nil correction", 9.0, nil, 50},
+		{"LRAPA", 10, LRAPA, 24},   // 4.34 → 4.3
+		{"AQandU", 10, AQandU, 53}, // 10.43 → 10.4
+	}
+
+	for _, tc := range tests {
+		got, err := AQIFromPM25With(tc.pm, tc.c)
+		if err != nil {
+			t.Errorf("%s: AQIFromPM25With(%v) returned error: %v", tc.name, tc.pm, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("%s: AQIFromPM25With(%v) = %d, want %d", tc.name, tc.pm, got, tc.want)
+		}
+	}
+}
+
+func TestAQIFromPM25WithInvalid(t *testing.T) {
+	negative := Correction(func(pm float64) float64 { return pm - 100 })
+
+	tests := []struct {
+		name string
+		pm   float64
+		c    Correction
+	}{
+		{"custom negative", 10, negative},
+		{"raw negative", -5, AQandU},
+		{"raw infinite", math.Inf(1), AQandU},
+		{"raw NaN", math.NaN(), LRAPA},
+	}
+
+	for _, tc := range tests {
+		if _, err := AQIFromPM25With(tc.pm, tc.c); !errors.Is(err, ErrInvalidConcentration) {
+			t.Errorf("%s: error = %v, want %v", tc.name, err, ErrInvalidConcentration)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package purpleair
+
+import (
+	"math"
+
+	"github.com/steventblack/purpleair/internal/floats"
+)
+
+// Thresholds for channel A/B agreement. Channels agree when either difference
+// is within its threshold, following the QA criteria used in the US EPA
+// PurpleAir correction (Barkjohn et al., 2021).
+const (
+	AgreementMaxAbsDiff = 5.0  // µg/m³
+	AgreementMaxPctDiff = 70.0 // percent
+)
+
+// Agreement compares the PM readings (µg/m³) of a sensor's A and B channels.
+// It returns the percent difference relative to the channel mean, the absolute
+// difference, and whether the channels agree within AgreementMaxAbsDiff or
+// AgreementMaxPctDiff. Negative or non-finite readings never agree and
+// report NaN differences.
+//
+// Only this pass/fail QA check is provided; the result is not a substitute
+// for the 0–100 confidence score reported by the API.
+func Agreement(a, b float64) (pctDiff, absDiff float64, ok bool) {
+	if !floats.NonNegative(a) || !floats.NonNegative(b) {
+		return math.NaN(), math.NaN(), false
+	}
+
+	absDiff = math.Abs(a - b)
+	if mean := (a + b) / 2; mean > 0 {
+		pctDiff = absDiff / mean * 100
+	}
+
+	return pctDiff, absDiff, absDiff <= AgreementMaxAbsDiff || pctDiff <= AgreementMaxPctDiff
+}
@@ -0,0 +1,39 @@
+package purpleair
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAgreement(t *testing.T) {
+	tests := []struct {
+		a, b    float64
+		pctDiff float64
+		absDiff float64
+		ok      bool
+	}{
+		{10, 10, 0, 0, true},
+		{0, 0, 0, 0, true},
+		{2, 6, 100, 4, true},        // large percent, small absolute
+		{100, 120, 18.18, 20, true}, // large absolute, small percent
+		{10, 30, 100, 20, false},
+		{30, 10, 100, 20, false},
+	}
+
+	for _, tc := range tests {
+		pct, abs, ok := Agreement(tc.a, tc.b)
+		if math.Abs(pct-tc.pctDiff) > 0.01 || math.Abs(abs-tc.absDiff) > 1e-9 || ok != tc.ok {
+			t.Errorf("Agreement(%v, %v) = %v, %v, %v; want %v, %v, %v",
+				tc.a, tc.b, pct, abs, ok, tc.pctDiff, tc.absDiff, tc.ok)
+		}
+	}
+}
+
+func TestAgreementInvalid(t *testing.T) {
+	for _, v := range []float64{-1, math.NaN(), math.Inf(1)} {
+		pct, abs, ok := Agreement(v, 10)
+		if ok || !math.IsNaN(pct) || !math.IsNaN(abs) {
+			t.Errorf("Agreement(%v, 10) = %v, %v, %v; want NaN, NaN, false", v, pct, abs, ok)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+// Package purpleair provides helpers for interpreting readings reported by
+// PurpleAir air quality sensors.
+package purpleair
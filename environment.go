@@ -0,0 +1,42 @@
+package purpleair
+
+// Offsets applied by the default environmental corrections. The sensor's
+// internal temperature reads about 8°F above ambient and its relative
+// humidity about 4% below, because the enclosure is warmed by the electronics.
+const (
+	DefaultTemperatureOffset = -8.0 // °F
+	DefaultHumidityOffset    = 4.0  // % RH
+)
+
+// TemperatureCorrection adjusts a temperature (°F) reported by a sensor.
+type TemperatureCorrection func(f float64) float64
+
+// HumidityCorrection adjusts a relative humidity (%) reported by a sensor.
+type HumidityCorrection func(rh float64) float64
+
+// Default environmental corrections using the default offsets.
+var (
+	DefaultTemperatureCorrection = TemperatureOffset(DefaultTemperatureOffset)
+	DefaultHumidityCorrection    = HumidityOffset(DefaultHumidityOffset)
+)
+
+// TemperatureOffset returns a correction that adds offset (°F) to a reading.
+func TemperatureOffset(offset float64) TemperatureCorrection {
+	return func(f float64) float64 { return f + offset }
+}
+
+// HumidityOffset returns a correction that adds offset (% RH) to a reading.
+// The corrected value is limited to the range 0–100%.
+func HumidityOffset(offset float64) HumidityCorrection {
+	return func(rh float64) float64 {
+		rh += offset
+		switch {
+		case rh < 0:
+			return 0
+		case rh > 100:
+			return 100
+		}
+
+		return rh
+	}
+}
@@ -0,0 +1,45 @@
+package purpleair
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTemperatureCorrection(t *testing.T) {
+	tests := []struct {
+		name string
+		c    TemperatureCorrection
+		f    float64
+		want float64
+	}{
+		{"default", DefaultTemperatureCorrection, 80, 72},
+		{"override", TemperatureOffset(-5.5), 80, 74.5},
+		{"zero", TemperatureOffset(0), 80, 80},
+	}
+
+	for _, tc := range tests {
+		if got := tc.c(tc.f); math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("%s(%v) = %v, want %v", tc.name, tc.f, got, tc.want)
+		}
+	}
+}
+
+func TestHumidityCorrection(t *testing.T) {
+	tests := []struct {
+		name string
+		c    HumidityCorrection
+		rh   float64
+		want float64
+	}{
+		{"default", DefaultHumidityCorrection, 40, 44},
+		{"override", HumidityOffset(2.5), 40, 42.5},
+		{"clamped high", DefaultHumidityCorrection, 98, 100},
+		{"clamped low", HumidityOffset(-10), 5, 0},
+	}
+
+	for _, tc := range tests {
+		if got := tc.c(tc.rh); math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("%s(%v) = %v, want %v", tc.name, tc.rh, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package purpleair
+
+import "errors"
+
+// Errors returned when analyzing series of samples.
+var (
+	ErrInsufficientData = errors.New("purpleair: insufficient data")
+	ErrInvalidSample    = errors.New("purpleair: invalid sample")
+	ErrInvalidThreshold = errors.New("purpleair: invalid threshold")
+	ErrInvalidWindow    = errors.New("purpleair: invalid time window")
+	ErrPartialCoverage  = errors.New("purpleair: samples do not cover time window")
+)
@@ -0,0 +1,61 @@
+package purpleair
+
+import (
+	"time"
+
+	"github.com/steventblack/purpleair/internal/floats"
+)
+
+// Dose estimates cumulative exposure over the window [from, to) by integrating
+// PM2.5 concentrations (µg/m³) with the trapezoidal rule, giving µg·h/m³.
+// Concentrations are interpolated linearly between samples, which need not be
+// ordered. The samples must span the whole window: ErrPartialCoverage is
+// returned if the first sample is after from or the last is before to, so
+// that missing data is never counted as clean air. ErrInvalidWindow is
+// returned if to is not after from.
+func Dose(samples []Sample, from, to time.Time) (float64, error) {
+	if !to.After(from) {
+		return 0, ErrInvalidWindow
+	}
+	if len(samples) < 2 {
+		return 0, ErrPartialCoverage
+	}
+
+	sorted := sortSamples(samples)
+	if sorted[0].Time.After(from) || sorted[len(sorted)-1].Time.Before(to) {
+		return 0, ErrPartialCoverage
+	}
+
+	var dose float64
+	for i := 0; i < len(sorted)-1; i++ {
+		s0, s1 := sorted[i], sorted[i+1]
+		if !floats.NonNegative(s0.Value) || !floats.NonNegative(s1.Value) {
+			return 0, ErrInvalidSample
+		}
+
+		start, end := s0.Time, s1.Time
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if !end.After(start) {
+			continue
+		}
+
+		dose += (interpolate(s0, s1, start) + interpolate(s0, s1, end)) / 2 * end.Sub(start).Hours()
+	}
+
+	return dose, nil
+}
+
+// interpolate returns the value at t on the line between s0 and s1.
+func interpolate(s0, s1 Sample, t time.Time) float64 {
+	span := s1.Time.Sub(s0.Time)
+	if span == 0 {
+		return s0.Value
+	}
+
+	return s0.Value + (s1.Value-s0.Value)*float64(t.Sub(s0.Time))/float64(span)
+}
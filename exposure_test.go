@@ -0,0 +1,64 @@
+package purpleair
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDose(t *testing.T) {
+	samples := series(time.Hour, 10, 20, 20, 0)
+	t0 := samples[0].Time
+
+	tests := []struct {
+		name     string
+		samples  []Sample
+		from, to time.Time
+		want     float64
+	}{
+		{"full window", samples, t0, t0.Add(3 * time.Hour), 15 + 20 + 10},
+		{"clipped start", samples, t0.Add(30 * time.Minute), t0.Add(2 * time.Hour), 8.75 + 20},
+		{"clipped end", samples, t0, t0.Add(90 * time.Minute), 15 + 10},
+		{"unordered", []Sample{samples[2], samples[0], samples[3], samples[1]}, t0, t0.Add(3 * time.Hour), 45},
+		{"constant", series(time.Hour, 12, 12), t0, t0.Add(time.Hour), 12},
+	}
+
+	for _, tc := range tests {
+		got, err := Dose(tc.samples, tc.from, tc.to)
+		if err != nil {
+			t.Errorf("%s: returned error: %v", tc.name, err)
+			continue
+		}
+		if math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("%s: Dose = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestDoseInvalid(t *testing.T) {
+	samples := series(time.Hour, 10, 10)
+	t0 := samples[0].Time
+
+	tests := []struct {
+		name     string
+		samples  []Sample
+		from, to time.Time
+		want     error
+	}{
+		{"empty window", samples, t0, t0, ErrInvalidWindow},
+		{"reversed window", samples, t0.Add(time.Hour), t0, ErrInvalidWindow},
+		{"partly covered", samples, t0, t0.Add(24 * time.Hour), ErrPartialCoverage},
+		{"starts before samples", samples, t0.Add(-time.Hour), t0.Add(time.Hour), ErrPartialCoverage},
+		{"after last sample", samples, t0.Add(2 * time.Hour), t0.Add(3 * time.Hour), ErrPartialCoverage},
+		{"no samples", nil, t0, t0.Add(time.Hour), ErrPartialCoverage},
+		{"single sample", samples[:1], t0, t0.Add(time.Hour), ErrPartialCoverage},
+		{"negative value", series(time.Hour, 10, -1), t0, t0.Add(time.Hour), ErrInvalidSample},
+	}
+
+	for _, tc := range tests {
+		if _, err := Dose(tc.samples, tc.from, tc.to); !errors.Is(err, tc.want) {
+			t.Errorf("%s: error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/steventblack/purpleair
+
+go 1.22
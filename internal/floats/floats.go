@@ -0,0 +1,9 @@
+// Package floats holds numeric helpers shared by the purpleair packages.
+package floats
+
+import "math"
+
+// NonNegative reports whether v is finite and not negative.
+func NonNegative(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0) && v >= 0
+}
@@ -0,0 +1,27 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNonNegative(t *testing.T) {
+	tests := []struct {
+		v    float64
+		want bool
+	}{
+		{0, true},
+		{12.5, true},
+		{math.MaxFloat64, true},
+		{-0.1, false},
+		{math.NaN(), false},
+		{math.Inf(1), false},
+		{math.Inf(-1), false},
+	}
+
+	for _, tc := range tests {
+		if got := NonNegative(tc.v); got != tc.want {
+			t.Errorf("NonNegative(%v) = %v, want %v", tc.v, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package purpleair
+
+import "math"
+
+// Unit conversion factors for pressure readings, which sensors report in millibars.
+const (
+	inHgPerMbar = 0.0295299830714
+	metersPerFt = 0.3048
+)
+
+// tropopauseFt is the top of the International Standard Atmosphere
+// troposphere (11,000 m), above which the barometric formula used by
+// SeaLevelPressure no longer applies.
+const tropopauseFt = 11000 / metersPerFt
+
+// PressureInHg converts a pressure in millibars to inches of mercury.
+func PressureInHg(mbar float64) float64 {
+	return mbar * inHgPerMbar
+}
+
+// PressureKPa converts a pressure in millibars to kilopascals.
+func PressureKPa(mbar float64) float64 {
+	return mbar / 10
+}
+
+// SeaLevelPressure normalizes a station pressure in millibars to sea level
+// using the sensor's altitude in feet, as reported in its altitude field.
+// It applies the International Standard Atmosphere barometric formula, which
+// holds only in the troposphere; NaN is returned for altitudes above the
+// tropopause at about 36,089 ft.
+func SeaLevelPressure(mbar, altitudeFt float64) float64 {
+	if altitudeFt > tropopauseFt {
+		return math.NaN()
+	}
+
+	return mbar * math.Pow(1-2.25577e-5*altitudeFt*metersPerFt, -5.25588)
+}
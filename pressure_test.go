@@ -0,0 +1,44 @@
+package purpleair
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPressureConversions(t *testing.T) {
+	if got, want := PressureInHg(1013.25), 29.9213; math.Abs(got-want) > 1e-4 {
+		t.Errorf("PressureInHg(1013.25) = %v, want %v", got, want)
+	}
+	if got, want := PressureKPa(1013.25), 101.325; math.Abs(got-want) > 1e-9 {
+		t.Errorf("PressureKPa(1013.25) = %v, want %v", got, want)
+	}
+}
+
+func TestSeaLevelPressure(t *testing.T) {
+	tests := []struct {
+		mbar, altitudeFt float64
+		want             float64
+	}{
+		{1000, 0, 1000},
+		{1000, 1000, 1036.93},
+		{840, 5280, 1020.20},
+	}
+
+	for _, tc := range tests {
+		if got := SeaLevelPressure(tc.mbar, tc.altitudeFt); math.Abs(got-tc.want) > 0.01 {
+			t.Errorf("SeaLevelPressure(%v, %v) = %v, want %v", tc.mbar, tc.altitudeFt, got, tc.want)
+		}
+	}
+
+	if got := SeaLevelPressure(1000, -282); got >= 1000 {
+		t.Errorf("SeaLevelPressure below sea level = %v, want < 1000", got)
+	}
+	if got := SeaLevelPressure(400, 36000); math.IsNaN(got) {
+		t.Errorf("SeaLevelPressure below tropopause = NaN, want a pressure")
+	}
+	for _, altitudeFt := range []float64{40000, 200000} {
+		if got := SeaLevelPressure(10, altitudeFt); !math.IsNaN(got) {
+			t.Errorf("SeaLevelPressure(10, %v) = %v, want NaN above the tropopause", altitudeFt, got)
+		}
+	}
+}
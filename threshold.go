@@ -0,0 +1,121 @@
+package purpleair
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Condition reports whether a sample meets some criterion, such as an AQI
+// above 150. Conditions compose with All and Any and are evaluated over time
+// with Sustained.
+type Condition func(s Sample) bool
+
+// Above returns a condition that holds when a sample's value exceeds threshold.
+func Above(threshold float64) Condition {
+	return func(s Sample) bool { return s.Value > threshold }
+}
+
+// Below returns a condition that holds when a sample's value is under threshold.
+func Below(threshold float64) Condition {
+	return func(s Sample) bool { return s.Value < threshold }
+}
+
+// All returns a condition that holds when every one of conds holds.
+func All(conds ...Condition) Condition {
+	return func(s Sample) bool {
+		for _, c := range conds {
+			if !c(s) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Any returns a condition that holds when at least one of conds holds.
+func Any(conds ...Condition) Condition {
+	return func(s Sample) bool {
+		for _, c := range conds {
+			if c(s) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Sustained reports whether cond has held for every sample over at least the
+// duration d up to the most recent sample, e.g. Sustained(samples, Above(150),
+// 30*time.Minute). Samples need not be ordered. It is false for no samples.
+func Sustained(samples []Sample, cond Condition, d time.Duration) bool {
+	if len(samples) == 0 {
+		return false
+	}
+
+	sorted := sortSamples(samples)
+	last := sorted[len(sorted)-1]
+	start := last.Time
+	for i := len(sorted) - 1; i >= 0 && cond(sorted[i]); i-- {
+		start = sorted[i].Time
+	}
+
+	return cond(last) && last.Time.Sub(start) >= d
+}
+
+// Exceeds reports whether value exceeds threshold given whether it was already
+// exceeding. Once exceeding, the value must fall to threshold − hysteresis or
+// below to clear, which stops readings hovering at the threshold from
+// toggling. Negative hysteresis is treated as zero, and NaN values leave the
+// state unchanged.
+func Exceeds(value, threshold, hysteresis float64, exceeding bool) bool {
+	if math.IsNaN(value) {
+		return exceeding
+	}
+	if exceeding {
+		return value > threshold-math.Max(hysteresis, 0)
+	}
+
+	return value > threshold
+}
+
+// Exceedance is a period during which samples exceeded a threshold.
+type Exceedance struct {
+	Start time.Time // Time of the first exceeding sample
+	End   time.Time // Time of the sample that cleared; zero if still exceeding
+	Peak  float64   // Highest value during the exceedance
+}
+
+// Exceedances returns the periods during which samples exceeded threshold,
+// applying hysteresis as Exceeds does. Samples need not be ordered.
+func Exceedances(samples []Sample, threshold, hysteresis float64) []Exceedance {
+	var (
+		events    []Exceedance
+		exceeding bool
+	)
+	for _, s := range sortSamples(samples) {
+		now := Exceeds(s.Value, threshold, hysteresis, exceeding)
+		switch {
+		case now && !exceeding:
+			events = append(events, Exceedance{Start: s.Time, Peak: s.Value})
+		case now:
+			e := &events[len(events)-1]
+			e.Peak = math.Max(e.Peak, s.Value)
+		case exceeding:
+			events[len(events)-1].End = s.Time
+		}
+		exceeding = now
+	}
+
+	return events
+}
+
+// sortSamples returns a copy of samples ordered by time.
+func sortSamples(samples []Sample) []Sample {
+	sorted := append([]Sample(nil), samples...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Time.Before(sorted[j].Time) })
+
+	return sorted
+}
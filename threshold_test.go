@@ -0,0 +1,101 @@
+package purpleair
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConditions(t *testing.T) {
+	s := Sample{Value: 120}
+
+	tests := []struct {
+		name string
+		c    Condition
+		want bool
+	}{
+		{"Above", Above(100), true},
+		{"Above equal", Above(120), false},
+		{"Below", Below(150), true},
+		{"All", All(Above(100), Below(150)), true},
+		{"All failing", All(Above(100), Below(110)), false},
+		{"All empty", All(), true},
+		{"Any", Any(Above(150), Below(130)), true},
+		{"Any failing", Any(Above(150), Below(100)), false},
+		{"Any empty", Any(), false},
+	}
+
+	for _, tc := range tests {
+		if got := tc.c(s); got != tc.want {
+			t.Errorf("%s(%v) = %v, want %v", tc.name, s.Value, got, tc.want)
+		}
+	}
+}
+
+func TestSustained(t *testing.T) {
+	step := 10 * time.Minute
+	rising := series(step, 160, 170, 180, 190)
+
+	tests := []struct {
+		name    string
+		samples []Sample
+		d       time.Duration
+		want    bool
+	}{
+		{"held for window", series(step, 100, 160, 170, 155, 180), 30 * time.Minute, true},
+		{"held too briefly", series(step, 100, 100, 160, 170, 180), 30 * time.Minute, false},
+		{"interrupted", series(step, 160, 170, 140, 155, 180), 30 * time.Minute, false},
+		{"latest fails", series(step, 160, 170, 180, 155, 140), 0, false},
+		{"zero duration", series(step, 100, 160), 0, true},
+		{"unordered", []Sample{rising[3], rising[0], rising[2], rising[1]}, 30 * time.Minute, true},
+		{"no samples", nil, 0, false},
+	}
+
+	for _, tc := range tests {
+		if got := Sustained(tc.samples, Above(150), tc.d); got != tc.want {
+			t.Errorf("%s: Sustained = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestExceeds(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      float64
+		hysteresis float64
+		exceeding  bool
+		want       bool
+	}{
+		{"rises above", 151, 10, false, true},
+		{"at threshold", 150, 10, false, false},
+		{"held within hysteresis", 145, 10, true, true},
+		{"clears below hysteresis", 140, 10, true, false},
+		{"negative hysteresis", 145, -10, true, false},
+		{"NaN keeps exceeding", math.NaN(), 10, true, true},
+		{"NaN keeps clear", math.NaN(), 10, false, false},
+	}
+
+	for _, tc := range tests {
+		if got := Exceeds(tc.value, 150, tc.hysteresis, tc.exceeding); got != tc.want {
+			t.Errorf("%s: Exceeds(%v) = %v, want %v", tc.name, tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestExceedances(t *testing.T) {
+	samples := series(time.Hour, 100, 160, 145, 175, 135, 120, 155, 170)
+	at := func(i int) time.Time { return samples[i].Time }
+
+	want := []Exceedance{
+		{Start: at(1), End: at(4), Peak: 175},
+		{Start: at(6), Peak: 170},
+	}
+	if got := Exceedances(samples, 150, 10); !reflect.DeepEqual(got, want) {
+		t.Errorf("Exceedances = %+v, want %+v", got, want)
+	}
+
+	if got := Exceedances(series(time.Hour, 10, 20), 150, 10); got != nil {
+		t.Errorf("Exceedances below threshold = %+v, want none", got)
+	}
+}
@@ -0,0 +1,94 @@
+package purpleair
+
+import (
+	"time"
+
+	"github.com/steventblack/purpleair/internal/floats"
+)
+
+// Sample is a single timestamped reading, such as a PM2.5 concentration or an AQI.
+type Sample struct {
+	Time  time.Time
+	Value float64
+}
+
+// Trend classifies the direction of a series of samples.
+type Trend int
+
+// Trend directions.
+const (
+	Steady Trend = iota
+	Rising
+	Falling
+)
+
+// String returns the name of the trend.
+func (t Trend) String() string {
+	switch t {
+	case Rising:
+		return "rising"
+	case Falling:
+		return "falling"
+	default:
+		return "steady"
+	}
+}
+
+// TrendResult describes the trend of a series of samples.
+type TrendResult struct {
+	Trend      Trend
+	Slope      float64 // Change in value per hour
+	Confidence float64 // Coefficient of determination (R²) of the fit, 0–1
+}
+
+// ClassifyTrend fits a least-squares line through samples and classifies the
+// series as Rising or Falling when the slope exceeds threshold (in value per
+// hour) in either direction, and as Steady otherwise. The threshold must be
+// finite and non-negative. At least two samples at distinct times are
+// required; samples need not be ordered.
+func ClassifyTrend(samples []Sample, threshold float64) (TrendResult, error) {
+	if !floats.NonNegative(threshold) {
+		return TrendResult{}, ErrInvalidThreshold
+	}
+	if len(samples) < 2 {
+		return TrendResult{}, ErrInsufficientData
+	}
+
+	t0 := samples[0].Time
+	var n, sx, sy float64
+	for _, s := range samples {
+		if !floats.NonNegative(s.Value) {
+			return TrendResult{}, ErrInvalidSample
+		}
+		n++
+		sx += s.Time.Sub(t0).Hours()
+		sy += s.Value
+	}
+	mx, my := sx/n, sy/n
+
+	var sxx, sxy, syy float64
+	for _, s := range samples {
+		dx := s.Time.Sub(t0).Hours() - mx
+		dy := s.Value - my
+		sxx += dx * dx
+		sxy += dx * dy
+		syy += dy * dy
+	}
+	if sxx == 0 {
+		return TrendResult{}, ErrInsufficientData
+	}
+
+	r := TrendResult{Slope: sxy / sxx, Confidence: 1}
+	if syy > 0 {
+		r.Confidence = sxy * sxy / (sxx * syy)
+	}
+
+	switch {
+	case r.Slope > threshold:
+		r.Trend = Rising
+	case r.Slope < -threshold:
+		r.Trend = Falling
+	}
+
+	return r, nil
+}
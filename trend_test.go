@@ -0,0 +1,72 @@
+package purpleair
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// series returns samples spaced step apart starting at a fixed time.
+func series(step time.Duration, values ...float64) []Sample {
+	t0 := time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+	samples := make([]Sample, len(values))
+	for i, v := range values {
+		samples[i] = Sample{Time: t0.Add(time.Duration(i) * step), Value: v}
+	}
+
+	return samples
+}
+
+func TestClassifyTrend(t *testing.T) {
+	tests := []struct {
+		name       string
+		samples    []Sample
+		want       Trend
+		slope      float64
+		confidence float64
+	}{
+		{"rising", series(30*time.Minute, 10, 15, 20, 25), Rising, 10, 1},
+		{"falling", series(time.Hour, 40, 30, 20), Falling, -10, 1},
+		{"flat", series(time.Hour, 12, 12, 12), Steady, 0, 1},
+		{"below threshold", series(time.Hour, 10, 10.5, 11), Steady, 0.5, 1},
+		{"noisy", series(time.Hour, 10, 20, 10, 20), Rising, 2, 0.2},
+	}
+
+	for _, tc := range tests {
+		got, err := ClassifyTrend(tc.samples, 1)
+		if err != nil {
+			t.Errorf("%s: returned error: %v", tc.name, err)
+			continue
+		}
+		if got.Trend != tc.want || math.Abs(got.Slope-tc.slope) > 1e-9 || math.Abs(got.Confidence-tc.confidence) > 1e-9 {
+			t.Errorf("%s: got %v (slope %v, confidence %v), want %v (slope %v, confidence %v)",
+				tc.name, got.Trend, got.Slope, got.Confidence, tc.want, tc.slope, tc.confidence)
+		}
+	}
+}
+
+func TestClassifyTrendInvalid(t *testing.T) {
+	flat := series(time.Hour, 12, 12, 12)
+
+	tests := []struct {
+		name      string
+		samples   []Sample
+		threshold float64
+		want      error
+	}{
+		{"empty", nil, 1, ErrInsufficientData},
+		{"single", series(time.Hour, 10), 1, ErrInsufficientData},
+		{"same time", series(0, 10, 20), 1, ErrInsufficientData},
+		{"NaN", series(time.Hour, 10, math.NaN()), 1, ErrInvalidSample},
+		{"negative threshold", flat, -1, ErrInvalidThreshold},
+		{"NaN threshold", flat, math.NaN(), ErrInvalidThreshold},
+		{"infinite threshold", flat, math.Inf(1), ErrInvalidThreshold},
+	}
+
+	for _, tc := range tests {
+		if _, err := ClassifyTrend(tc.samples, tc.threshold); !errors.Is(err, tc.want) {
+			t.Errorf("%s: error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+}
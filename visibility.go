@@ -0,0 +1,68 @@
+package purpleair
+
+import (
+	"math"
+
+	"github.com/steventblack/purpleair/internal/floats"
+)
+
+// Constants for visibility estimates. Scattering coefficients are particle
+// light scattering in inverse megameters (Mm⁻¹), the quantity and unit of the
+// API's scattering_coefficient field. The functions below add Rayleigh
+// scattering themselves to obtain total extinction.
+const (
+	// RayleighScattering is the extinction of particle-free air in Mm⁻¹,
+	// used as the zero point of the deciview scale.
+	RayleighScattering = 10.0
+
+	// pm25ScatteringEfficiency is the dry mass scattering efficiency of fine
+	// particles in m²/g; 1 µg/m³ of PM2.5 scatters about 3 Mm⁻¹.
+	pm25ScatteringEfficiency = 3.0
+
+	// koschmieder is the Koschmieder constant for a 2% contrast threshold,
+	// scaled so that visual range in km = koschmieder / extinction in Mm⁻¹.
+	koschmieder = 3912.0
+
+	kmPerMile = 1.609344
+)
+
+// ScatteringFromPM25 estimates particle light scattering (Mm⁻¹) for a PM2.5
+// concentration in µg/m³. It assumes a typical dry mass scattering efficiency
+// and ignores humidity growth and absorption, so it is an approximation.
+// NaN is returned for negative or non-finite concentrations.
+func ScatteringFromPM25(pm float64) float64 {
+	if !floats.NonNegative(pm) {
+		return math.NaN()
+	}
+
+	return pm25ScatteringEfficiency * pm
+}
+
+// Deciviews converts particle scattering (Mm⁻¹), such as the API's
+// scattering_coefficient, to the deciview haze index. Rayleigh scattering is
+// added internally, so particle-free air is 0 dv and the index rises by about
+// one for each just-perceptible change in haze. NaN is returned for negative
+// or non-finite scattering.
+func Deciviews(scattering float64) float64 {
+	if !floats.NonNegative(scattering) {
+		return math.NaN()
+	}
+
+	return 10 * math.Log((scattering+RayleighScattering)/RayleighScattering)
+}
+
+// VisualRangeKm returns the visual range in kilometers for particle scattering
+// (Mm⁻¹) using the Koschmieder relation on total extinction, including
+// Rayleigh scattering. NaN is returned for negative or non-finite scattering.
+func VisualRangeKm(scattering float64) float64 {
+	if !floats.NonNegative(scattering) {
+		return math.NaN()
+	}
+
+	return koschmieder / (scattering + RayleighScattering)
+}
+
+// VisualRangeMiles returns the visual range in miles for particle scattering (Mm⁻¹).
+func VisualRangeMiles(scattering float64) float64 {
+	return VisualRangeKm(scattering) / kmPerMile
+}
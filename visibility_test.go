@@ -0,0 +1,48 @@
+package purpleair
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVisibility(t *testing.T) {
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"ScatteringFromPM25(0)", ScatteringFromPM25(0), 0},
+		{"ScatteringFromPM25(30)", ScatteringFromPM25(30), 90},
+		{"Deciviews(0)", Deciviews(0), 0},
+		{"Deciviews(5)", Deciviews(5), 4.055},
+		{"Deciviews(90)", Deciviews(90), 23.026},
+		{"VisualRangeKm(0)", VisualRangeKm(0), 391.2},
+		{"VisualRangeKm(90)", VisualRangeKm(90), 39.12},
+		{"VisualRangeMiles(90)", VisualRangeMiles(90), 24.308},
+	}
+
+	for _, tc := range tests {
+		if math.Abs(tc.got-tc.want) > 1e-3 {
+			t.Errorf("%s = %v, want %v", tc.name, tc.got, tc.want)
+		}
+	}
+}
+
+func TestVisibilityInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		got  float64
+	}{
+		{"ScatteringFromPM25(-1)", ScatteringFromPM25(-1)},
+		{"ScatteringFromPM25(NaN)", ScatteringFromPM25(math.NaN())},
+		{"Deciviews(-5)", Deciviews(-5)},
+		{"VisualRangeKm(-1)", VisualRangeKm(-1)},
+		{"VisualRangeMiles(+Inf)", VisualRangeMiles(math.Inf(1))},
+	}
+
+	for _, tc := range tests {
+		if !math.IsNaN(tc.got) {
+			t.Errorf("%s = %v, want NaN", tc.name, tc.got)
+		}
+	}
+}